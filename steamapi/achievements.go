@@ -0,0 +1,126 @@
+package steamapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Achievement is a single achievement's unlock state for one player, as
+// returned by GetPlayerAchievements and embedded in GetUserStatsForGame.
+type Achievement struct {
+	APIName    string `json:"apiname"`
+	Achieved   int    `json:"achieved"`
+	UnlockTime int64  `json:"unlocktime"`
+}
+
+// Stat is a single numeric game stat for one player, as returned by
+// GetUserStatsForGame.
+type Stat struct {
+	Name  string  `json:"name"`
+	Value float64 `json:"value"`
+}
+
+// AchievementPercentage is the fraction of all players who have unlocked a
+// given achievement, as returned by GetGlobalAchievementPercentagesForApp.
+type AchievementPercentage struct {
+	Name    string  `json:"name"`
+	Percent float64 `json:"percent"`
+}
+
+// ErrAchievementsUnavailable is returned when Steam reports that
+// achievement or stat data isn't available for the requested player/app
+// pair, e.g. because the game has no achievements or the profile is private.
+var ErrAchievementsUnavailable = errors.New("steamapi: achievements unavailable")
+
+type playerAchievementsResult struct {
+	PlayerStats struct {
+		SteamID      string        `json:"steamID"`
+		GameName     string        `json:"gameName"`
+		Achievements []Achievement `json:"achievements"`
+		Success      bool          `json:"success"`
+		Error        string        `json:"error"`
+	} `json:"playerstats"`
+}
+
+// PlayerAchievements returns steamID's achievement progress for appID, via
+// ISteamUserStats/GetPlayerAchievements.
+func (c *Client) PlayerAchievements(ctx context.Context, steamID string, appID int) ([]Achievement, error) {
+	resp, err := c.do(ctx, userStatsService, "GetPlayerAchievements", "v1", url.Values{
+		"steamid": {steamID},
+		"appid":   {strconv.Itoa(appID)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var parsed playerAchievementsResult
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("steamapi: %s %s: %w", userStatsService, "GetPlayerAchievements", err)
+	}
+	if !parsed.PlayerStats.Success {
+		return nil, fmt.Errorf("%w: %s", ErrAchievementsUnavailable, parsed.PlayerStats.Error)
+	}
+	return parsed.PlayerStats.Achievements, nil
+}
+
+// UserStatsForGame holds a player's stats and achievements for one game, as
+// returned by GetUserStatsForGame.
+type UserStatsForGame struct {
+	SteamID      string        `json:"steamID"`
+	GameName     string        `json:"gameName"`
+	Stats        []Stat        `json:"stats"`
+	Achievements []Achievement `json:"achievements"`
+}
+
+type userStatsForGameResult struct {
+	PlayerStats UserStatsForGame `json:"playerstats"`
+}
+
+// UserStatsForGame returns steamID's stats and achievements for appID, via
+// ISteamUserStats/GetUserStatsForGame.
+func (c *Client) UserStatsForGame(ctx context.Context, steamID string, appID int) (UserStatsForGame, error) {
+	resp, err := c.do(ctx, userStatsService, "GetUserStatsForGame", "v2", url.Values{
+		"steamid": {steamID},
+		"appid":   {strconv.Itoa(appID)},
+	})
+	if err != nil {
+		return UserStatsForGame{}, err
+	}
+	defer resp.Body.Close()
+
+	var parsed userStatsForGameResult
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return UserStatsForGame{}, fmt.Errorf("steamapi: %s %s: %w", userStatsService, "GetUserStatsForGame", err)
+	}
+	return parsed.PlayerStats, nil
+}
+
+type globalAchievementPercentagesResult struct {
+	AchievementPercentages struct {
+		Achievements []AchievementPercentage `json:"achievements"`
+	} `json:"achievementpercentages"`
+}
+
+// GlobalAchievementPercentages returns, for every achievement in appID, the
+// percentage of players who have unlocked it, via
+// ISteamUserStats/GetGlobalAchievementPercentagesForApp.
+func (c *Client) GlobalAchievementPercentages(ctx context.Context, appID int) ([]AchievementPercentage, error) {
+	resp, err := c.do(ctx, userStatsService, "GetGlobalAchievementPercentagesForApp", "v2", url.Values{
+		"gameid": {strconv.Itoa(appID)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var parsed globalAchievementPercentagesResult
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("steamapi: %s %s: %w", userStatsService, "GetGlobalAchievementPercentagesForApp", err)
+	}
+	return parsed.AchievementPercentages.Achievements, nil
+}
@@ -0,0 +1,64 @@
+package steamapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"softsrv/steamapi/steamapi"
+	"softsrv/steamapi/steamapi/steamapitest"
+)
+
+func TestPlayerAchievements(t *testing.T) {
+	client := steamapitest.NewClient(t, "testdata/achievements.json")
+
+	achievements, err := client.PlayerAchievements(context.Background(), "76561197960435530", 440)
+	if err != nil {
+		t.Fatalf("PlayerAchievements() error = %v, want nil", err)
+	}
+	if len(achievements) != 1 {
+		t.Fatalf("PlayerAchievements() returned %d achievements, want 1", len(achievements))
+	}
+	if achievements[0].APIName != "TF_PLAY_GAME_EVERYMAP" {
+		t.Errorf("achievements[0].APIName = %q, want %q", achievements[0].APIName, "TF_PLAY_GAME_EVERYMAP")
+	}
+}
+
+func TestPlayerAchievementsUnavailable(t *testing.T) {
+	client := steamapitest.NewClient(t, "testdata/achievements.json")
+
+	_, err := client.PlayerAchievements(context.Background(), "76561197960435530", 1)
+	if !errors.Is(err, steamapi.ErrAchievementsUnavailable) {
+		t.Fatalf("PlayerAchievements() error = %v, want %v", err, steamapi.ErrAchievementsUnavailable)
+	}
+}
+
+func TestUserStatsForGame(t *testing.T) {
+	client := steamapitest.NewClient(t, "testdata/achievements.json")
+
+	stats, err := client.UserStatsForGame(context.Background(), "76561197960435530", 440)
+	if err != nil {
+		t.Fatalf("UserStatsForGame() error = %v, want nil", err)
+	}
+	if len(stats.Stats) != 1 || stats.Stats[0].Name != "kills" {
+		t.Fatalf("UserStatsForGame().Stats = %+v, want one \"kills\" stat", stats.Stats)
+	}
+	if len(stats.Achievements) != 1 {
+		t.Errorf("UserStatsForGame().Achievements has %d entries, want 1", len(stats.Achievements))
+	}
+}
+
+func TestGlobalAchievementPercentages(t *testing.T) {
+	client := steamapitest.NewClient(t, "testdata/achievements.json")
+
+	percentages, err := client.GlobalAchievementPercentages(context.Background(), 440)
+	if err != nil {
+		t.Fatalf("GlobalAchievementPercentages() error = %v, want nil", err)
+	}
+	if len(percentages) != 1 {
+		t.Fatalf("GlobalAchievementPercentages() returned %d entries, want 1", len(percentages))
+	}
+	if got := percentages[0].Percent; got != 85.3 {
+		t.Errorf("percentages[0].Percent = %v, want 85.3", got)
+	}
+}
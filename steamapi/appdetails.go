@@ -0,0 +1,73 @@
+package steamapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// ErrAppNotFound is returned by AppDetails when the Storefront API has no
+// listing for the requested appID.
+var ErrAppNotFound = errors.New("steamapi: app not found")
+
+// AppDetails contains the subset of Storefront appdetails fields most
+// callers need.
+type AppDetails struct {
+	Type                string   `json:"type"`
+	Name                string   `json:"name"`
+	SteamAppID          int      `json:"steam_appid"`
+	RequiredAge         int      `json:"required_age"`
+	IsFree              bool     `json:"is_free"`
+	DetailedDescription string   `json:"detailed_description"`
+	ShortDescription    string   `json:"short_description"`
+	HeaderImage         string   `json:"header_image"`
+	Website             string   `json:"website"`
+	Developers          []string `json:"developers"`
+	Publishers          []string `json:"publishers"`
+	Platforms           struct {
+		Windows bool `json:"windows"`
+		Mac     bool `json:"mac"`
+		Linux   bool `json:"linux"`
+	} `json:"platforms"`
+	ReleaseDate struct {
+		ComingSoon bool   `json:"coming_soon"`
+		Date       string `json:"date"`
+	} `json:"release_date"`
+}
+
+type appDetailsEnvelope struct {
+	Success bool       `json:"success"`
+	Data    AppDetails `json:"data"`
+}
+
+// AppDetails fetches storefront metadata for appID from the Storefront API
+// (store.steampowered.com/api/appdetails). Unlike the rest of the client's
+// methods this endpoint is unauthenticated and keyed by a country code cc
+// and language l (Steam's two-letter codes, e.g. "us" and "english") rather
+// than an API key. It returns ErrAppNotFound if Steam has no listing for
+// appID.
+func (c *Client) AppDetails(ctx context.Context, appID int, cc, l string) (AppDetails, error) {
+	resp, err := c.doStorefront(ctx, "api/appdetails", url.Values{
+		"appids": {strconv.Itoa(appID)},
+		"cc":     {cc},
+		"l":      {l},
+	})
+	if err != nil {
+		return AppDetails{}, err
+	}
+	defer resp.Body.Close()
+
+	var parsed map[string]appDetailsEnvelope
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return AppDetails{}, fmt.Errorf("steamapi: store api/appdetails: %w", err)
+	}
+
+	envelope, ok := parsed[strconv.Itoa(appID)]
+	if !ok || !envelope.Success {
+		return AppDetails{}, ErrAppNotFound
+	}
+	return envelope.Data, nil
+}
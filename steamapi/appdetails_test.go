@@ -0,0 +1,37 @@
+package steamapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"softsrv/steamapi/steamapi"
+	"softsrv/steamapi/steamapi/steamapitest"
+)
+
+func TestAppDetails(t *testing.T) {
+	client := steamapitest.NewClient(t, "testdata/appdetails.json")
+
+	got, err := client.AppDetails(context.Background(), 440, "us", "english")
+	if err != nil {
+		t.Fatalf("AppDetails() error = %v, want nil", err)
+	}
+	if got.Name != "Team Fortress 2" {
+		t.Errorf("AppDetails().Name = %q, want %q", got.Name, "Team Fortress 2")
+	}
+	if !got.IsFree {
+		t.Errorf("AppDetails().IsFree = false, want true")
+	}
+	if !got.Platforms.Linux {
+		t.Errorf("AppDetails().Platforms.Linux = false, want true")
+	}
+}
+
+func TestAppDetailsNotFound(t *testing.T) {
+	client := steamapitest.NewClient(t, "testdata/appdetails.json")
+
+	_, err := client.AppDetails(context.Background(), 999999999, "us", "english")
+	if !errors.Is(err, steamapi.ErrAppNotFound) {
+		t.Fatalf("AppDetails() error = %v, want %v", err, steamapi.ErrAppNotFound)
+	}
+}
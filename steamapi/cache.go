@@ -0,0 +1,124 @@
+package steamapi
+
+import (
+	"container/list"
+	"context"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Cache is the pluggable storage backend for do's response cache. Get
+// reports whether key is present and not yet expired; Set stores value
+// under key for ttl.
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+// cacheKeyFor derives the cache key for reqURL, stripping the "key" query
+// parameter so the caller's Steam API key is never handed to a pluggable
+// Cache implementation (e.g. Redis, disk, a remote KV store).
+func cacheKeyFor(reqURL string) string {
+	u, err := url.Parse(reqURL)
+	if err != nil {
+		return reqURL
+	}
+	q := u.Query()
+	q.Del("key")
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// TTLPolicy maps a Steam endpoint, identified as "iface/method" (e.g.
+// "ISteamUser/GetPlayerSummaries"), to how long responses from it may be
+// served from cache. Endpoints with no entry are never cached.
+type TTLPolicy map[string]time.Duration
+
+// DefaultTTLPolicy is a reasonable starting point for WithCache: player
+// summaries change often, friends lists rarely.
+var DefaultTTLPolicy = TTLPolicy{
+	"ISteamUser/GetPlayerSummaries": 60 * time.Second,
+	"IPlayerService/GetOwnedGames":  10 * time.Minute,
+	"ISteamUser/GetFriendList":      time.Hour,
+}
+
+type noCacheKey struct{}
+
+// NoCache returns a context that bypasses the client's cache for calls made
+// with it, even when the client was configured with WithCache.
+func NoCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noCacheKey{}, true)
+}
+
+func noCacheFromContext(ctx context.Context) bool {
+	bypass, _ := ctx.Value(noCacheKey{}).(bool)
+	return bypass
+}
+
+// ttlLRUCache is the default Cache: an in-memory LRU with per-entry expiry,
+// safe for concurrent use.
+type ttlLRUCache struct {
+	mu       sync.Mutex
+	maxItems int
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+type ttlLRUEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewTTLCache returns a Cache backed by an in-memory LRU that evicts the
+// least-recently-used entry once it holds more than maxItems. A maxItems of
+// 0 means unbounded.
+func NewTTLCache(maxItems int) Cache {
+	return &ttlLRUCache{
+		maxItems: maxItems,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+func (t *ttlLRUCache) Get(key string) ([]byte, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	elem, ok := t.items[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*ttlLRUEntry)
+	if time.Now().After(entry.expiresAt) {
+		t.ll.Remove(elem)
+		delete(t.items, key)
+		return nil, false
+	}
+	t.ll.MoveToFront(elem)
+	return entry.value, true
+}
+
+func (t *ttlLRUCache) Set(key string, value []byte, ttl time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if elem, ok := t.items[key]; ok {
+		entry := elem.Value.(*ttlLRUEntry)
+		entry.value = value
+		entry.expiresAt = time.Now().Add(ttl)
+		t.ll.MoveToFront(elem)
+		return
+	}
+
+	elem := t.ll.PushFront(&ttlLRUEntry{key: key, value: value, expiresAt: time.Now().Add(ttl)})
+	t.items[key] = elem
+
+	if t.maxItems > 0 && t.ll.Len() > t.maxItems {
+		if oldest := t.ll.Back(); oldest != nil {
+			t.ll.Remove(oldest)
+			delete(t.items, oldest.Value.(*ttlLRUEntry).key)
+		}
+	}
+}
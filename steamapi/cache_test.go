@@ -0,0 +1,146 @@
+package steamapi_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"softsrv/steamapi/steamapi"
+)
+
+// countingDoer returns statusCode on every call and counts how many times
+// it was invoked, so tests can assert whether the cache short-circuited the
+// transport.
+type countingDoer struct {
+	statusCode int
+	body       string
+	calls      int
+}
+
+func (d *countingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.calls++
+	body := d.body
+	if body == "" {
+		return &http.Response{
+			StatusCode: d.statusCode,
+			Body:       http.NoBody,
+			Header:     make(http.Header),
+		}, nil
+	}
+	return &http.Response{
+		StatusCode: d.statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}, nil
+}
+
+func TestTTLLRUCacheExpiry(t *testing.T) {
+	cache := steamapi.NewTTLCache(0)
+
+	cache.Set("a", []byte("1"), time.Millisecond)
+	if _, ok := cache.Get("a"); !ok {
+		t.Fatalf("Get(a) = not found immediately after Set")
+	}
+
+	time.Sleep(5 * time.Millisecond)
+	if _, ok := cache.Get("a"); ok {
+		t.Errorf("Get(a) = found after ttl elapsed, want expired")
+	}
+}
+
+func TestTTLLRUCacheEviction(t *testing.T) {
+	cache := steamapi.NewTTLCache(2)
+
+	cache.Set("a", []byte("1"), time.Minute)
+	cache.Set("b", []byte("2"), time.Minute)
+	// Touch "a" so "b" becomes the least-recently-used entry.
+	cache.Get("a")
+	cache.Set("c", []byte("3"), time.Minute)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Errorf("Get(b) = found, want evicted as least-recently-used")
+	}
+	if _, ok := cache.Get("a"); !ok {
+		t.Errorf("Get(a) = not found, want retained")
+	}
+	if _, ok := cache.Get("c"); !ok {
+		t.Errorf("Get(c) = not found, want retained")
+	}
+}
+
+func TestClientCacheSkipsErrorResponses(t *testing.T) {
+	doer := &countingDoer{statusCode: http.StatusForbidden}
+	client := steamapi.NewClient("test-api-key",
+		steamapi.WithHTTPClient(doer),
+		steamapi.WithCache(steamapi.NewTTLCache(0), steamapi.TTLPolicy{
+			"IPlayerService/GetOwnedGames": time.Hour,
+		}),
+		steamapi.WithRetryPolicy(steamapi.RetryPolicy{}),
+	)
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.Games(context.Background(), "76561197960435530"); err == nil {
+			t.Fatalf("Games() call %d: error = nil, want a 403 error", i)
+		}
+	}
+
+	if doer.calls != 3 {
+		t.Errorf("transport invoked %d times, want 3 (a 403 response must never be served from cache)", doer.calls)
+	}
+}
+
+func TestClientCacheHitAvoidsTransport(t *testing.T) {
+	doer := &countingDoer{
+		statusCode: http.StatusOK,
+		body:       `{"response":{"games":[{"appid":440,"name":"Team Fortress 2"}]}}`,
+	}
+	client := steamapi.NewClient("test-api-key",
+		steamapi.WithHTTPClient(doer),
+		steamapi.WithCache(steamapi.NewTTLCache(0), steamapi.TTLPolicy{
+			"IPlayerService/GetOwnedGames": time.Hour,
+		}),
+		steamapi.WithRetryPolicy(steamapi.RetryPolicy{}),
+	)
+
+	if _, err := client.Games(context.Background(), "76561197960435530"); err != nil {
+		t.Fatalf("Games() call 1: error = %v, want nil", err)
+	}
+	if _, err := client.Games(context.Background(), "76561197960435530"); err != nil {
+		t.Fatalf("Games() call 2: error = %v, want nil", err)
+	}
+
+	if doer.calls != 1 {
+		t.Errorf("transport invoked %d times, want 1 (the second call should be served from cache)", doer.calls)
+	}
+}
+
+func TestNoCacheBypassesCache(t *testing.T) {
+	// A cacheable 200 response, so only NoCache can keep the second call
+	// from being served out of the cache.
+	doer := &countingDoer{
+		statusCode: http.StatusOK,
+		body:       `{"response":{"games":[{"appid":440,"name":"Team Fortress 2"}]}}`,
+	}
+	client := steamapi.NewClient("test-api-key",
+		steamapi.WithHTTPClient(doer),
+		steamapi.WithCache(steamapi.NewTTLCache(0), steamapi.TTLPolicy{
+			"IPlayerService/GetOwnedGames": time.Hour,
+		}),
+		steamapi.WithRetryPolicy(steamapi.RetryPolicy{}),
+	)
+
+	ctx := steamapi.NoCache(context.Background())
+	if _, err := client.Games(ctx, "76561197960435530"); err != nil {
+		t.Fatalf("Games() call 1: error = %v, want nil", err)
+	}
+	if _, err := client.Games(ctx, "76561197960435530"); err != nil {
+		t.Fatalf("Games() call 2: error = %v, want nil", err)
+	}
+
+	if doer.calls != 2 {
+		t.Errorf("transport invoked %d times, want 2 (NoCache must bypass the cache entirely)", doer.calls)
+	}
+}
@@ -0,0 +1,283 @@
+// Package steamapi implements a client over some of steam's webapis
+package steamapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+	"log/slog"
+)
+
+const (
+	defaultBaseURL    = "https://api.steampowered.com"
+	storefrontBaseURL = "https://store.steampowered.com"
+	userService       = "ISteamUser"
+	playerService     = "IPlayerService"
+	userStatsService  = "ISteamUserStats"
+
+	// steamRequestQuota and steamQuotaWindow describe Steam's documented web
+	// API rate limit of 200 requests per 5 minutes.
+	steamRequestQuota = 200
+	steamQuotaWindow  = 5 * time.Minute
+
+	// defaultMaxConcurrency bounds how many chunked requests (e.g. from
+	// Players) run in flight at once.
+	defaultMaxConcurrency = 4
+)
+
+// Doer is satisfied by *http.Client and lets callers substitute their own
+// HTTP transport, e.g. for tracing or testing.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// RetryPolicy controls how the client retries requests that fail with a 5xx
+// or 429 response.
+type RetryPolicy struct {
+	// MaxRetries is the number of additional attempts made after the first.
+	MaxRetries int
+	// BaseDelay is the delay before the first retry; later retries back off
+	// exponentially from it.
+	BaseDelay time.Duration
+	// MaxDelay caps the computed backoff delay.
+	MaxDelay time.Duration
+}
+
+var defaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	BaseDelay:  500 * time.Millisecond,
+	MaxDelay:   10 * time.Second,
+}
+
+// Client is the type that owns methods for fetching steam data.
+type Client struct {
+	httpClient     Doer
+	baseURL        string
+	apiKey         string
+	userAgent      string
+	logger         *slog.Logger
+	limiter        *rate.Limiter
+	retryPolicy    RetryPolicy
+	maxConcurrency int
+	cache          Cache
+	cachePolicy    TTLPolicy
+}
+
+// Option configures a Client returned by NewClient.
+type Option func(*Client)
+
+// WithHTTPClient overrides the Doer used to execute requests.
+func WithHTTPClient(hc Doer) Option {
+	return func(c *Client) { c.httpClient = hc }
+}
+
+// WithBaseURL overrides the API base URL, primarily useful for pointing at a
+// test server.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) { c.baseURL = strings.TrimRight(baseURL, "/") }
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(ua string) Option {
+	return func(c *Client) { c.userAgent = ua }
+}
+
+// WithLogger enables structured request/response logging via slog. By
+// default the client logs nothing.
+func WithLogger(l *slog.Logger) Option {
+	return func(c *Client) { c.logger = l }
+}
+
+// WithRateLimit overrides the token-bucket limiter applied to outgoing
+// requests. By default the client honors Steam's documented 200
+// requests/5 minutes quota.
+func WithRateLimit(requestsPerInterval int, interval time.Duration) Option {
+	return func(c *Client) {
+		c.limiter = rate.NewLimiter(rate.Every(interval/time.Duration(requestsPerInterval)), requestsPerInterval)
+	}
+}
+
+// WithRetryPolicy overrides the retry/backoff behavior for 5xx and 429
+// responses.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) { c.retryPolicy = policy }
+}
+
+// WithMaxConcurrency bounds how many chunked requests (e.g. the per-100
+// calls Players makes for large steamID lists) run concurrently.
+func WithMaxConcurrency(n int) Option {
+	return func(c *Client) { c.maxConcurrency = n }
+}
+
+// WithCache enables response caching through cache, using policy to decide
+// which endpoints are cacheable and for how long. Endpoints absent from
+// policy are never cached.
+func WithCache(cache Cache, policy TTLPolicy) Option {
+	return func(c *Client) {
+		c.cache = cache
+		c.cachePolicy = policy
+	}
+}
+
+// NewClient returns a client struct configured with the provided Steam web
+// API key and any Options.
+func NewClient(apiKey string, opts ...Option) *Client {
+	c := &Client{
+		httpClient:     &http.Client{},
+		baseURL:        defaultBaseURL,
+		apiKey:         apiKey,
+		limiter:        rate.NewLimiter(rate.Every(steamQuotaWindow/steamRequestQuota), steamRequestQuota),
+		retryPolicy:    defaultRetryPolicy,
+		maxConcurrency: defaultMaxConcurrency,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// do issues a GET request against iface/method/version, merging in the
+// client's API key, and returns the raw HTTP response. It is the choke
+// point every ISteam*/IPlayerService typed method routes through, so rate
+// limiting, retries, caching, and logging only need to be implemented once.
+func (c *Client) do(ctx context.Context, iface, method, version string, params url.Values) (*http.Response, error) {
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("key", c.apiKey)
+
+	reqURL := fmt.Sprintf("%s/%s/%s/%s?%s", c.baseURL, iface, method, version, params.Encode())
+	return c.execute(ctx, iface, method, reqURL)
+}
+
+// doStorefront issues a GET request against the unauthenticated Storefront
+// API (store.steampowered.com), which uses its own path and query
+// conventions instead of the ISteam*/IPlayerService iface/method/version
+// shape. It shares do's rate limiting, retries, caching, and logging via
+// execute.
+func (c *Client) doStorefront(ctx context.Context, path string, params url.Values) (*http.Response, error) {
+	if params == nil {
+		params = url.Values{}
+	}
+	reqURL := fmt.Sprintf("%s/%s?%s", storefrontBaseURL, path, params.Encode())
+	return c.execute(ctx, "store", path, reqURL)
+}
+
+// execute runs the shared request lifecycle -- cache lookup, rate limiting,
+// retries with backoff, logging, and cache population -- for a fully formed
+// reqURL. iface and method are used only as cache/log keys and in wrapped
+// errors.
+func (c *Client) execute(ctx context.Context, iface, method, reqURL string) (*http.Response, error) {
+	ttl, cacheable := c.cachePolicy[iface+"/"+method]
+	useCache := c.cache != nil && cacheable && !noCacheFromContext(ctx)
+	var cacheKey string
+	if useCache {
+		cacheKey = cacheKeyFor(reqURL)
+		if cached, ok := c.cache.Get(cacheKey); ok {
+			c.logf(ctx, slog.LevelDebug, "steamapi: cache hit", "iface", iface, "method", method)
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(cached))}, nil
+		}
+	}
+
+	var resp *http.Response
+	var lastErr error
+	for attempt := 0; attempt <= c.retryPolicy.MaxRetries; attempt++ {
+		if attempt > 0 {
+			delay := backoffDelay(c.retryPolicy, attempt, resp)
+			c.logf(ctx, slog.LevelWarn, "steamapi: retrying request", "iface", iface, "method", method, "attempt", attempt, "delay", delay)
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("steamapi: %s %s: %w", iface, method, err)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+		if err != nil {
+			return nil, fmt.Errorf("steamapi: %s %s: %w", iface, method, err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if c.userAgent != "" {
+			req.Header.Set("User-Agent", c.userAgent)
+		}
+
+		c.logf(ctx, slog.LevelDebug, "steamapi: sending request", "iface", iface, "method", method, "attempt", attempt)
+
+		resp, lastErr = c.httpClient.Do(req)
+		if lastErr != nil {
+			c.logf(ctx, slog.LevelWarn, "steamapi: request failed", "iface", iface, "method", method, "err", lastErr)
+			continue
+		}
+
+		c.logf(ctx, slog.LevelDebug, "steamapi: received response", "iface", iface, "method", method, "status", resp.StatusCode)
+
+		if !shouldRetry(resp.StatusCode) {
+			if useCache {
+				return c.cacheAndReturn(resp, cacheKey, ttl)
+			}
+			return resp, nil
+		}
+		lastErr = fmt.Errorf("unexpected status %d", resp.StatusCode)
+		resp.Body.Close()
+	}
+
+	return nil, fmt.Errorf("steamapi: %s %s: %w", iface, method, lastErr)
+}
+
+// cacheAndReturn buffers resp's body so it can be stored under key, then
+// hands the caller a fresh response reading from that buffer. Only 2xx
+// responses are actually stored -- caching a 4xx/5xx would otherwise mask a
+// bad API key, exceeded quota, or not-found error for the rest of its TTL.
+func (c *Client) cacheAndReturn(resp *http.Response, key string, ttl time.Duration) (*http.Response, error) {
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("steamapi: reading response for cache: %w", err)
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		c.cache.Set(key, data, ttl)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	return resp, nil
+}
+
+func shouldRetry(status int) bool {
+	return status == http.StatusTooManyRequests || status >= 500
+}
+
+// backoffDelay computes how long to wait before the next attempt, honoring
+// Retry-After on 429 responses and otherwise backing off exponentially from
+// policy.BaseDelay.
+func backoffDelay(policy RetryPolicy, attempt int, resp *http.Response) time.Duration {
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		if ra := resp.Header.Get("Retry-After"); ra != "" {
+			if secs, err := strconv.Atoi(ra); err == nil {
+				return time.Duration(secs) * time.Second
+			}
+		}
+	}
+	delay := policy.BaseDelay * time.Duration(int64(1)<<uint(attempt-1))
+	if delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+	return delay
+}
+
+func (c *Client) logf(ctx context.Context, level slog.Level, msg string, args ...any) {
+	if c.logger == nil {
+		return
+	}
+	c.logger.Log(ctx, level, msg, args...)
+}
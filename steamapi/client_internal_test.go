@@ -0,0 +1,68 @@
+package steamapi
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestBackoffDelay(t *testing.T) {
+	policy := RetryPolicy{
+		MaxRetries: 5,
+		BaseDelay:  100 * time.Millisecond,
+		MaxDelay:   time.Second,
+	}
+
+	tests := []struct {
+		name    string
+		attempt int
+		want    time.Duration
+	}{
+		{"first retry", 1, 100 * time.Millisecond},
+		{"second retry doubles", 2, 200 * time.Millisecond},
+		{"third retry doubles again", 3, 400 * time.Millisecond},
+		{"exceeds MaxDelay, so it's capped", 5, time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := backoffDelay(policy, tt.attempt, nil); got != tt.want {
+				t.Errorf("backoffDelay(%+v, %d, nil) = %v, want %v", policy, tt.attempt, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackoffDelayHonorsRetryAfter(t *testing.T) {
+	policy := RetryPolicy{
+		MaxRetries: 5,
+		BaseDelay:  100 * time.Millisecond,
+		MaxDelay:   time.Second,
+	}
+
+	resp := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"3"}},
+	}
+
+	if got, want := backoffDelay(policy, 1, resp), 3*time.Second; got != want {
+		t.Errorf("backoffDelay() = %v, want %v (Retry-After must override the exponential backoff)", got, want)
+	}
+}
+
+func TestBackoffDelayIgnoresRetryAfterOnNon429(t *testing.T) {
+	policy := RetryPolicy{
+		MaxRetries: 5,
+		BaseDelay:  100 * time.Millisecond,
+		MaxDelay:   time.Second,
+	}
+
+	resp := &http.Response{
+		StatusCode: http.StatusInternalServerError,
+		Header:     http.Header{"Retry-After": []string{"3"}},
+	}
+
+	if got, want := backoffDelay(policy, 1, resp), 100*time.Millisecond; got != want {
+		t.Errorf("backoffDelay() = %v, want %v (Retry-After only applies to 429s)", got, want)
+	}
+}
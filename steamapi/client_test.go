@@ -0,0 +1,116 @@
+package steamapi_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"softsrv/steamapi/steamapi"
+)
+
+// sequenceDoer returns one canned response per call, in order, and counts
+// how many times it was invoked. The last response repeats once the
+// sequence is exhausted, so retry-exhaustion tests can keep failing.
+type sequenceDoer struct {
+	responses []*http.Response
+	calls     int
+}
+
+func (d *sequenceDoer) Do(req *http.Request) (*http.Response, error) {
+	idx := d.calls
+	if idx >= len(d.responses) {
+		idx = len(d.responses) - 1
+	}
+	resp := d.responses[idx]
+	d.calls++
+	return resp, nil
+}
+
+func statusResponse(status int, header http.Header) *http.Response {
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		StatusCode: status,
+		Body:       http.NoBody,
+		Header:     header,
+	}
+}
+
+func fastRetryPolicy() steamapi.RetryPolicy {
+	return steamapi.RetryPolicy{
+		MaxRetries: 3,
+		BaseDelay:  time.Millisecond,
+		MaxDelay:   5 * time.Millisecond,
+	}
+}
+
+func TestExecuteRetriesOn5xxThenSucceeds(t *testing.T) {
+	doer := &sequenceDoer{responses: []*http.Response{
+		statusResponse(http.StatusServiceUnavailable, nil),
+		statusResponse(http.StatusOK, nil),
+	}}
+	client := steamapi.NewClient("test-api-key",
+		steamapi.WithHTTPClient(doer),
+		steamapi.WithRetryPolicy(fastRetryPolicy()),
+	)
+
+	// The second response has no body, so Games() will fail to decode it,
+	// but that's fine -- we only care that the transport was hit twice
+	// before execute stopped retrying.
+	client.Games(context.Background(), "76561197960435530")
+
+	if doer.calls != 2 {
+		t.Errorf("transport invoked %d times, want 2 (one 503 retry then a 200)", doer.calls)
+	}
+}
+
+func TestExecuteRetriesOn429HonoringRetryAfter(t *testing.T) {
+	doer := &sequenceDoer{responses: []*http.Response{
+		statusResponse(http.StatusTooManyRequests, http.Header{"Retry-After": []string{"0"}}),
+		statusResponse(http.StatusOK, nil),
+	}}
+	// The exponential backoff alone would wait BaseDelay (5s) before the
+	// retry, so finishing in under a second shows Retry-After was used.
+	client := steamapi.NewClient("test-api-key",
+		steamapi.WithHTTPClient(doer),
+		steamapi.WithRetryPolicy(steamapi.RetryPolicy{
+			MaxRetries: 3,
+			BaseDelay:  5 * time.Second,
+			MaxDelay:   5 * time.Second,
+		}),
+	)
+
+	start := time.Now()
+	client.Games(context.Background(), "76561197960435530")
+	elapsed := time.Since(start)
+
+	if doer.calls != 2 {
+		t.Errorf("transport invoked %d times, want 2 (one 429 retry then a 200)", doer.calls)
+	}
+	if elapsed > time.Second {
+		t.Errorf("execute() took %v, want it to honor the zero-second Retry-After rather than falling back to backoff", elapsed)
+	}
+}
+
+func TestExecuteExhaustsRetries(t *testing.T) {
+	doer := &sequenceDoer{responses: []*http.Response{
+		statusResponse(http.StatusServiceUnavailable, nil),
+	}}
+	policy := fastRetryPolicy()
+	client := steamapi.NewClient("test-api-key",
+		steamapi.WithHTTPClient(doer),
+		steamapi.WithRetryPolicy(policy),
+	)
+
+	_, err := client.Games(context.Background(), "76561197960435530")
+	if err == nil {
+		t.Fatalf("Games() error = nil, want an error once retries are exhausted")
+	}
+
+	wantCalls := policy.MaxRetries + 1
+	if doer.calls != wantCalls {
+		t.Errorf("transport invoked %d times, want %d (initial attempt + MaxRetries)", doer.calls, wantCalls)
+	}
+}
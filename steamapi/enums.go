@@ -0,0 +1,99 @@
+package steamapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PersonaState represents a Steam user's online/presence state, as reported
+// by GetPlayerSummaries.
+type PersonaState int
+
+// Persona states as documented by the Steam Web API.
+const (
+	PersonaStateOffline PersonaState = iota
+	PersonaStateOnline
+	PersonaStateBusy
+	PersonaStateAway
+	PersonaStateSnooze
+	PersonaStateLookingToTrade
+	PersonaStateLookingToPlay
+)
+
+// String returns the human-readable name of the persona state.
+func (p PersonaState) String() string {
+	switch p {
+	case PersonaStateOffline:
+		return "Offline"
+	case PersonaStateOnline:
+		return "Online"
+	case PersonaStateBusy:
+		return "Busy"
+	case PersonaStateAway:
+		return "Away"
+	case PersonaStateSnooze:
+		return "Snooze"
+	case PersonaStateLookingToTrade:
+		return "LookingToTrade"
+	case PersonaStateLookingToPlay:
+		return "LookingToPlay"
+	default:
+		return fmt.Sprintf("PersonaState(%d)", int(p))
+	}
+}
+
+// MarshalJSON encodes PersonaState as Steam's underlying integer.
+func (p PersonaState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int(p))
+}
+
+// UnmarshalJSON decodes PersonaState from Steam's integer representation.
+func (p *PersonaState) UnmarshalJSON(data []byte) error {
+	var i int
+	if err := json.Unmarshal(data, &i); err != nil {
+		return err
+	}
+	*p = PersonaState(i)
+	return nil
+}
+
+// CommunityVisibility represents whether a player's Steam Community profile
+// is public, friends-only, or private.
+type CommunityVisibility int
+
+// Community visibility states as documented by the Steam Web API.
+const (
+	CommunityVisibilityPrivate     CommunityVisibility = 1
+	CommunityVisibilityFriendsOnly CommunityVisibility = 2
+	CommunityVisibilityPublic      CommunityVisibility = 3
+)
+
+// String returns the human-readable name of the visibility state.
+func (v CommunityVisibility) String() string {
+	switch v {
+	case CommunityVisibilityPrivate:
+		return "Private"
+	case CommunityVisibilityFriendsOnly:
+		return "FriendsOnly"
+	case CommunityVisibilityPublic:
+		return "Public"
+	default:
+		return fmt.Sprintf("CommunityVisibility(%d)", int(v))
+	}
+}
+
+// MarshalJSON encodes CommunityVisibility as Steam's underlying integer.
+func (v CommunityVisibility) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int(v))
+}
+
+// UnmarshalJSON decodes CommunityVisibility from Steam's integer
+// representation.
+func (v *CommunityVisibility) UnmarshalJSON(data []byte) error {
+	var i int
+	if err := json.Unmarshal(data, &i); err != nil {
+		return err
+	}
+	*v = CommunityVisibility(i)
+	return nil
+}
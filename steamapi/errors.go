@@ -0,0 +1,24 @@
+package steamapi
+
+import "strings"
+
+// MultiError collects the errors encountered while fanning out concurrent
+// requests, e.g. the chunked calls Players makes for large steamID lists.
+// The individual errors are preserved in the order their chunks were
+// issued.
+type MultiError struct {
+	Errors []error
+}
+
+func (m *MultiError) Error() string {
+	msgs := make([]string, len(m.Errors))
+	for i, err := range m.Errors {
+		msgs[i] = err.Error()
+	}
+	return "steamapi: " + strings.Join(msgs, "; ")
+}
+
+// Unwrap allows errors.Is and errors.As to inspect the individual errors.
+func (m *MultiError) Unwrap() []error {
+	return m.Errors
+}
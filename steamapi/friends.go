@@ -0,0 +1,48 @@
+package steamapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// A Friend is a reference to a Player who is friends with a particular user
+type Friend struct {
+	SteamID     string `json:"steamid"`
+	FriendSince int    `json:"friend_since"`
+}
+
+// FriendsList contains an array of Friend objects
+type FriendsList struct {
+	Friends []Friend `json:"friends"`
+}
+
+// FriendsResult contains a "friendslist" object with relevant data
+type FriendsResult struct {
+	FriendsList FriendsList `json:"friendslist"`
+}
+
+// Friends accepts a steamID and returns all friends for that ID as a slice of Player
+func (c *Client) Friends(ctx context.Context, steamID string) ([]Player, error) {
+	resp, err := c.do(ctx, userService, "GetFriendList", "v0001", url.Values{
+		"steamid":      {steamID},
+		"relationship": {"friend"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var parsed FriendsResult
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("steamapi: %s %s: %w", userService, "GetFriendList", err)
+	}
+
+	idList := make([]string, 0, len(parsed.FriendsList.Friends))
+	for _, friend := range parsed.FriendsList.Friends {
+		idList = append(idList, friend.SteamID)
+	}
+
+	return c.Players(ctx, idList)
+}
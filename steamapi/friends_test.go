@@ -0,0 +1,41 @@
+package steamapi_test
+
+import (
+	"context"
+	"testing"
+
+	"softsrv/steamapi/steamapi/steamapitest"
+)
+
+func TestFriends(t *testing.T) {
+	tests := []struct {
+		name        string
+		steamID     string
+		wantFriends []string
+	}{
+		{
+			name:        "resolves friend list to players",
+			steamID:     "76561197960435530",
+			wantFriends: []string{"Gabe"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := steamapitest.NewClient(t, "testdata/friends.json")
+
+			friends, err := client.Friends(context.Background(), tt.steamID)
+			if err != nil {
+				t.Fatalf("Friends() error = %v", err)
+			}
+			if len(friends) != len(tt.wantFriends) {
+				t.Fatalf("Friends() returned %d friends, want %d", len(friends), len(tt.wantFriends))
+			}
+			for i, want := range tt.wantFriends {
+				if got := friends[i].PersonaName; got != want {
+					t.Errorf("friends[%d].PersonaName = %q, want %q", i, got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package steamapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Game contains details about a Steam game
+type Game struct {
+	AppID           int    `json:"appid"`
+	Name            string `json:"name"`
+	PlaytimeForever int    `json:"playtime_forever"`
+	ImgIconURL      string `json:"img_icon_url"`
+	ImgLogoURL      string `json:"img_logo_url"`
+	Playtime2Weeks  int    `json:"playtime_2weeks,omitempty"`
+}
+
+// GamesList contains a slice of Game objects
+type GamesList struct {
+	Games []Game `json:"games"`
+}
+
+// GamesResult contains a "response" object with relevant data
+type GamesResult struct {
+	Response GamesList `json:"response"`
+}
+
+// Games accepts one steamID and returns a slice of Game
+func (c *Client) Games(ctx context.Context, steamID string) ([]Game, error) {
+	resp, err := c.do(ctx, playerService, "GetOwnedGames", "v0001", url.Values{
+		"steamid":                   {steamID},
+		"include_appinfo":           {"1"},
+		"include_played_free_games": {"1"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var parsed GamesResult
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("steamapi: %s %s: %w", playerService, "GetOwnedGames", err)
+	}
+	return parsed.Response.Games, nil
+}
@@ -0,0 +1,39 @@
+package steamapi_test
+
+import (
+	"context"
+	"testing"
+
+	"softsrv/steamapi/steamapi/steamapitest"
+)
+
+func TestGames(t *testing.T) {
+	tests := []struct {
+		name     string
+		steamID  string
+		wantGame string
+	}{
+		{
+			name:     "owned games",
+			steamID:  "76561197960435530",
+			wantGame: "Team Fortress 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := steamapitest.NewClient(t, "testdata/games.json")
+
+			games, err := client.Games(context.Background(), tt.steamID)
+			if err != nil {
+				t.Fatalf("Games() error = %v", err)
+			}
+			if len(games) != 1 {
+				t.Fatalf("Games() returned %d games, want 1", len(games))
+			}
+			if got := games[0].Name; got != tt.wantGame {
+				t.Errorf("games[0].Name = %q, want %q", got, tt.wantGame)
+			}
+		})
+	}
+}
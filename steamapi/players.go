@@ -0,0 +1,158 @@
+package steamapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxPlayerSummariesPerRequest is the number of steamids GetPlayerSummaries
+// accepts in a single call.
+const maxPlayerSummariesPerRequest = 100
+
+// Player contains details about the Steam User
+type Player struct {
+	SteamID      string `json:"steamid"`
+	PersonaName  string `json:"personaname"`
+	AvatarSmall  string `json:"avatar"`
+	AvatarMedium string `json:"avatarmedium"`
+	AvatarFull   string `json:"avatarfull"`
+
+	CommunityVisibilityState CommunityVisibility `json:"communityvisibilitystate"`
+	ProfileState             int                 `json:"profilestate"`
+	LastLogoff               time.Time           `json:"-"`
+	ProfileURL               string              `json:"profileurl"`
+	PersonaState             PersonaState        `json:"personastate"`
+	RealName                 string              `json:"realname"`
+	PrimaryClanID            string              `json:"primaryclanid"`
+	TimeCreated              time.Time           `json:"-"`
+	PersonaStateFlags        int                 `json:"personastateflags"`
+	LocCountryCode           string              `json:"loccountrycode"`
+	LocStateCode             string              `json:"locstatecode"`
+	LocCityID                int                 `json:"loccityid"`
+	GameID                   string              `json:"gameid"`
+	GameServerIP             string              `json:"gameserverip"`
+	GameExtraInfo            string              `json:"gameextrainfo"`
+}
+
+// UnmarshalJSON decodes a Player, converting the lastlogoff and timecreated
+// unix-second timestamps Steam sends into time.Time.
+func (p *Player) UnmarshalJSON(data []byte) error {
+	type playerAlias Player
+	aux := struct {
+		LastLogoff  int64 `json:"lastlogoff"`
+		TimeCreated int64 `json:"timecreated"`
+		*playerAlias
+	}{
+		playerAlias: (*playerAlias)(p),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if aux.LastLogoff != 0 {
+		p.LastLogoff = time.Unix(aux.LastLogoff, 0).UTC()
+	}
+	if aux.TimeCreated != 0 {
+		p.TimeCreated = time.Unix(aux.TimeCreated, 0).UTC()
+	}
+	return nil
+}
+
+// PlayersList contains a slice of Player objects.
+type PlayersList struct {
+	Players []Player `json:"players"`
+}
+
+// PlayersResult contains a "response" object with relevant data
+type PlayersResult struct {
+	Response PlayersList `json:"response"`
+}
+
+// Players accepts any number of steamIDs and returns a slice of Player in
+// the same order. GetPlayerSummaries caps at 100 ids per call, so inputs
+// longer than that are split into chunks and fetched concurrently across up
+// to the client's configured max concurrency (see WithMaxConcurrency). If
+// one or more chunks fail, Players still returns the players it did manage
+// to fetch alongside a *MultiError describing the failures.
+func (c *Client) Players(ctx context.Context, steamIDs []string) ([]Player, error) {
+	chunks := chunkStrings(steamIDs, maxPlayerSummariesPerRequest)
+	if len(chunks) <= 1 {
+		return c.playersChunk(ctx, steamIDs)
+	}
+
+	results := make([][]Player, len(chunks))
+	errs := make([]error, len(chunks))
+
+	sem := make(chan struct{}, c.maxConcurrency)
+	var wg sync.WaitGroup
+	for i, chunk := range chunks {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, chunk []string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i], errs[i] = c.playersChunk(ctx, chunk)
+		}(i, chunk)
+	}
+	wg.Wait()
+
+	var merged []Player
+	var failures []error
+	for i, players := range results {
+		merged = append(merged, players...)
+		if errs[i] != nil {
+			failures = append(failures, errs[i])
+		}
+	}
+	if len(failures) > 0 {
+		return merged, &MultiError{Errors: failures}
+	}
+	return merged, nil
+}
+
+// playersChunk fetches a single GetPlayerSummaries call's worth of
+// steamIDs, which must number 100 or fewer.
+func (c *Client) playersChunk(ctx context.Context, steamIDs []string) ([]Player, error) {
+	resp, err := c.do(ctx, userService, "GetPlayerSummaries", "v0002", url.Values{
+		"steamids": {strings.Join(steamIDs, ",")},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var parsed PlayersResult
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("steamapi: %s %s: %w", userService, "GetPlayerSummaries", err)
+	}
+	return parsed.Response.Players, nil
+}
+
+// chunkStrings splits items into contiguous slices of at most size,
+// preserving order.
+func chunkStrings(items []string, size int) [][]string {
+	if len(items) == 0 {
+		return nil
+	}
+	chunks := make([][]string, 0, (len(items)+size-1)/size)
+	for size < len(items) {
+		items, chunks = items[size:], append(chunks, items[:size:size])
+	}
+	return append(chunks, items)
+}
+
+// Player accepts one steamID and returns that player's object
+func (c *Client) Player(ctx context.Context, steamID string) (Player, error) {
+	players, err := c.Players(ctx, []string{steamID})
+	if err != nil {
+		return Player{}, err
+	}
+	if len(players) == 0 {
+		return Player{}, fmt.Errorf("steamapi: %s %s: no player found for steamid %s", userService, "GetPlayerSummaries", steamID)
+	}
+	return players[0], nil
+}
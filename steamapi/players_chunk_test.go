@@ -0,0 +1,81 @@
+package steamapi_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"softsrv/steamapi/steamapi"
+)
+
+// playersChunkDoer replies based on the "steamids" query param, so a test
+// can give each chunk of a fanned-out Players call its own canned response.
+type playersChunkDoer struct {
+	responses map[string]struct {
+		status int
+		body   string
+	}
+}
+
+func (d *playersChunkDoer) Do(req *http.Request) (*http.Response, error) {
+	steamids := req.URL.Query().Get("steamids")
+	resp, ok := d.responses[steamids]
+	if !ok {
+		return nil, fmt.Errorf("playersChunkDoer: unexpected steamids %q", steamids)
+	}
+	return &http.Response{
+		StatusCode: resp.status,
+		Body:       io.NopCloser(strings.NewReader(resp.body)),
+		Header:     make(http.Header),
+	}, nil
+}
+
+func TestPlayersChunksAndMergesInOrder(t *testing.T) {
+	ids := make([]string, 150)
+	for i := range ids {
+		ids[i] = strconv.Itoa(76561197960265728 + i)
+	}
+	firstChunk := strings.Join(ids[:100], ",")
+	secondChunk := strings.Join(ids[100:], ",")
+
+	doer := &playersChunkDoer{responses: map[string]struct {
+		status int
+		body   string
+	}{
+		firstChunk: {
+			status: http.StatusOK,
+			body:   `{"response":{"players":[{"steamid":"` + ids[0] + `","personaname":"first-chunk-player"}]}}`,
+		},
+		secondChunk: {
+			status: http.StatusInternalServerError,
+			body:   `{}`,
+		},
+	}}
+
+	client := steamapi.NewClient("test-api-key",
+		steamapi.WithHTTPClient(doer),
+		steamapi.WithRetryPolicy(steamapi.RetryPolicy{}),
+	)
+
+	players, err := client.Players(context.Background(), ids)
+
+	var multi *steamapi.MultiError
+	if !errors.As(err, &multi) {
+		t.Fatalf("Players() error = %v, want *MultiError", err)
+	}
+	if len(multi.Errors) != 1 {
+		t.Fatalf("MultiError.Errors has %d entries, want 1 (the failed second chunk)", len(multi.Errors))
+	}
+
+	if len(players) != 1 {
+		t.Fatalf("Players() returned %d players, want 1 (only the first chunk succeeded)", len(players))
+	}
+	if players[0].SteamID != ids[0] {
+		t.Errorf("players[0].SteamID = %q, want %q (first chunk's result must lead, preserving input order)", players[0].SteamID, ids[0])
+	}
+}
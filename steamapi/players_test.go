@@ -0,0 +1,69 @@
+package steamapi_test
+
+import (
+	"context"
+	"testing"
+
+	"softsrv/steamapi/steamapi"
+	"softsrv/steamapi/steamapi/steamapitest"
+)
+
+func TestPlayers(t *testing.T) {
+	tests := []struct {
+		name      string
+		steamIDs  []string
+		wantNames []string
+	}{
+		{
+			name:      "single steamid",
+			steamIDs:  []string{"76561197960435530"},
+			wantNames: []string{"Robin"},
+		},
+		{
+			name:      "multiple steamids",
+			steamIDs:  []string{"76561197960435530", "76561197960265731"},
+			wantNames: []string{"Robin", "Gabe"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := steamapitest.NewClient(t, "testdata/players.json")
+
+			players, err := client.Players(context.Background(), tt.steamIDs)
+			if err != nil {
+				t.Fatalf("Players() error = %v", err)
+			}
+
+			if len(players) != len(tt.wantNames) {
+				t.Fatalf("Players() returned %d players, want %d", len(players), len(tt.wantNames))
+			}
+			for i, want := range tt.wantNames {
+				if got := players[i].PersonaName; got != want {
+					t.Errorf("players[%d].PersonaName = %q, want %q", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPlayer(t *testing.T) {
+	client := steamapitest.NewClient(t, "testdata/players.json")
+
+	player, err := client.Player(context.Background(), "76561197960435530")
+	if err != nil {
+		t.Fatalf("Player() error = %v", err)
+	}
+	if player.PersonaName != "Robin" {
+		t.Errorf("PersonaName = %q, want %q", player.PersonaName, "Robin")
+	}
+	if player.PersonaState != steamapi.PersonaStateOnline {
+		t.Errorf("PersonaState = %v, want %v", player.PersonaState, steamapi.PersonaStateOnline)
+	}
+	if player.CommunityVisibilityState != steamapi.CommunityVisibilityPublic {
+		t.Errorf("CommunityVisibilityState = %v, want %v", player.CommunityVisibilityState, steamapi.CommunityVisibilityPublic)
+	}
+	if player.LastLogoff.Unix() != 1700000000 {
+		t.Errorf("LastLogoff.Unix() = %d, want %d", player.LastLogoff.Unix(), 1700000000)
+	}
+}
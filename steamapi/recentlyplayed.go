@@ -0,0 +1,34 @@
+package steamapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// RecentlyPlayedGamesResult contains a "response" object with relevant data
+type RecentlyPlayedGamesResult struct {
+	Response struct {
+		TotalCount int    `json:"total_count"`
+		Games      []Game `json:"games"`
+	} `json:"response"`
+}
+
+// RecentlyPlayedGames returns the games steamID has played in the last two
+// weeks, via IPlayerService/GetRecentlyPlayedGames.
+func (c *Client) RecentlyPlayedGames(ctx context.Context, steamID string) ([]Game, error) {
+	resp, err := c.do(ctx, playerService, "GetRecentlyPlayedGames", "v1", url.Values{
+		"steamid": {steamID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var parsed RecentlyPlayedGamesResult
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("steamapi: %s %s: %w", playerService, "GetRecentlyPlayedGames", err)
+	}
+	return parsed.Response.Games, nil
+}
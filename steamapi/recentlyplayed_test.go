@@ -0,0 +1,23 @@
+package steamapi_test
+
+import (
+	"context"
+	"testing"
+
+	"softsrv/steamapi/steamapi/steamapitest"
+)
+
+func TestRecentlyPlayedGames(t *testing.T) {
+	client := steamapitest.NewClient(t, "testdata/recentlyplayed.json")
+
+	games, err := client.RecentlyPlayedGames(context.Background(), "76561197960435530")
+	if err != nil {
+		t.Fatalf("RecentlyPlayedGames() error = %v", err)
+	}
+	if len(games) != 1 {
+		t.Fatalf("RecentlyPlayedGames() returned %d games, want 1", len(games))
+	}
+	if got := games[0].Playtime2Weeks; got != 42 {
+		t.Errorf("games[0].Playtime2Weeks = %d, want 42", got)
+	}
+}
@@ -0,0 +1,140 @@
+// Package steamapitest provides a record/replay HTTP transport for testing
+// code that uses steamapi.Client, without hitting the network or needing a
+// real API key.
+package steamapitest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"softsrv/steamapi/steamapi"
+)
+
+// Fixture is one recorded request/response pair. URL is normalized (its
+// "key" query parameter stripped) so fixtures recorded with one API key can
+// be replayed against a client configured with another.
+type Fixture struct {
+	URL        string `json:"url"`
+	StatusCode int    `json:"status_code"`
+	Body       string `json:"body"`
+}
+
+// RecordingTransport wraps a real steamapi.Doer and saves every response it
+// sees, so the traffic from a single test run can be replayed later with
+// ReplayTransport.
+type RecordingTransport struct {
+	Doer steamapi.Doer
+
+	mu       sync.Mutex
+	fixtures []Fixture
+}
+
+// Do satisfies steamapi.Doer, recording the response before returning it.
+func (r *RecordingTransport) Do(req *http.Request) (*http.Response, error) {
+	resp, err := r.Doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.fixtures = append(r.fixtures, Fixture{
+		URL:        normalizeURL(req.URL),
+		StatusCode: resp.StatusCode,
+		Body:       string(body),
+	})
+	r.mu.Unlock()
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// Save writes every fixture recorded so far to path as JSON.
+func (r *RecordingTransport) Save(path string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := json.MarshalIndent(r.fixtures, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// ReplayTransport serves fixtures recorded by RecordingTransport back
+// deterministically, matched by normalized request URL.
+type ReplayTransport struct {
+	fixtures map[string]Fixture
+}
+
+// LoadReplayTransport reads fixtures previously saved by RecordingTransport.
+func LoadReplayTransport(path string) (*ReplayTransport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("steamapitest: reading fixtures %s: %w", path, err)
+	}
+
+	var fixtures []Fixture
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		return nil, fmt.Errorf("steamapitest: parsing fixtures %s: %w", path, err)
+	}
+
+	byURL := make(map[string]Fixture, len(fixtures))
+	for _, f := range fixtures {
+		byURL[f.URL] = f
+	}
+	return &ReplayTransport{fixtures: byURL}, nil
+}
+
+// Do satisfies steamapi.Doer, serving the fixture recorded for req's
+// normalized URL.
+func (r *ReplayTransport) Do(req *http.Request) (*http.Response, error) {
+	key := normalizeURL(req.URL)
+	fixture, ok := r.fixtures[key]
+	if !ok {
+		return nil, fmt.Errorf("steamapitest: no fixture recorded for %s", key)
+	}
+	return &http.Response{
+		StatusCode: fixture.StatusCode,
+		Body:       io.NopCloser(strings.NewReader(fixture.Body)),
+		Header:     make(http.Header),
+	}, nil
+}
+
+// normalizeURL strips the "key" query parameter so fixtures don't depend on
+// which API key recorded or replayed them.
+func normalizeURL(u *url.URL) string {
+	q := u.Query()
+	q.Del("key")
+	normalized := *u
+	normalized.RawQuery = q.Encode()
+	return normalized.String()
+}
+
+// NewClient returns a steamapi.Client wired to replay the fixtures saved at
+// fixturePath, so tests can exercise real Client methods without network
+// access or an API key. It fails t if fixturePath can't be loaded.
+func NewClient(t *testing.T, fixturePath string, opts ...steamapi.Option) *steamapi.Client {
+	t.Helper()
+
+	transport, err := LoadReplayTransport(fixturePath)
+	if err != nil {
+		t.Fatalf("steamapitest.NewClient: %v", err)
+	}
+
+	allOpts := append([]steamapi.Option{steamapi.WithHTTPClient(transport)}, opts...)
+	return steamapi.NewClient("test-api-key", allOpts...)
+}
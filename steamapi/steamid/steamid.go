@@ -0,0 +1,60 @@
+// Package steamid converts between the SteamID64, SteamID2, and SteamID3
+// formats used across Steam's APIs and the community site, so callers of
+// steamapi can normalize whichever id a user supplies before looking it up.
+package steamid
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// baseSteamID64 is the SteamID64 of account number 0 in the public
+// universe, i.e. 76561197960265728. Every individual account's SteamID64 is
+// this value plus its 32-bit account id.
+const baseSteamID64 uint64 = 76561197960265728
+
+// SteamID64ToSteamID2 converts a SteamID64 to the legacy STEAM_X:Y:Z format.
+func SteamID64ToSteamID2(steamID64 uint64) string {
+	accountID := steamID64 - baseSteamID64
+	y := accountID & 1
+	z := accountID >> 1
+	return fmt.Sprintf("STEAM_0:%d:%d", y, z)
+}
+
+// SteamID2ToSteamID64 converts a legacy STEAM_X:Y:Z id to a SteamID64.
+func SteamID2ToSteamID64(steamID2 string) (uint64, error) {
+	parts := strings.Split(steamID2, ":")
+	if len(parts) != 3 || !strings.HasPrefix(parts[0], "STEAM_") {
+		return 0, fmt.Errorf("steamid: %q is not a valid SteamID2", steamID2)
+	}
+	y, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("steamid: %q is not a valid SteamID2: %w", steamID2, err)
+	}
+	z, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("steamid: %q is not a valid SteamID2: %w", steamID2, err)
+	}
+	return baseSteamID64 + z*2 + y, nil
+}
+
+// SteamID64ToSteamID3 converts a SteamID64 to the [U:1:Z] format used by
+// newer Steam APIs and console commands.
+func SteamID64ToSteamID3(steamID64 uint64) string {
+	accountID := steamID64 - baseSteamID64
+	return fmt.Sprintf("[U:1:%d]", accountID)
+}
+
+// SteamID3ToSteamID64 converts a [U:1:Z] id to a SteamID64.
+func SteamID3ToSteamID64(steamID3 string) (uint64, error) {
+	inner := strings.TrimPrefix(steamID3, "[U:1:")
+	if inner == steamID3 || !strings.HasSuffix(inner, "]") {
+		return 0, fmt.Errorf("steamid: %q is not a valid SteamID3", steamID3)
+	}
+	accountID, err := strconv.ParseUint(strings.TrimSuffix(inner, "]"), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("steamid: %q is not a valid SteamID3: %w", steamID3, err)
+	}
+	return baseSteamID64 + accountID, nil
+}
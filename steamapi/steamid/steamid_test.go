@@ -0,0 +1,86 @@
+package steamid_test
+
+import (
+	"testing"
+
+	"softsrv/steamapi/steamapi/steamid"
+)
+
+func TestSteamID64ToSteamID2(t *testing.T) {
+	tests := []struct {
+		name      string
+		steamID64 uint64
+		want      string
+	}{
+		{"even account id", 76561197960265728, "STEAM_0:0:0"},
+		{"odd account id", 76561197960265729, "STEAM_0:1:0"},
+		{"larger account id", 76561197960287930, "STEAM_0:0:11101"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := steamid.SteamID64ToSteamID2(tt.steamID64); got != tt.want {
+				t.Errorf("SteamID64ToSteamID2(%d) = %q, want %q", tt.steamID64, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSteamID64ToSteamID3(t *testing.T) {
+	tests := []struct {
+		name      string
+		steamID64 uint64
+		want      string
+	}{
+		{"account id zero", 76561197960265728, "[U:1:0]"},
+		{"larger account id", 76561197960287930, "[U:1:22202]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := steamid.SteamID64ToSteamID3(tt.steamID64); got != tt.want {
+				t.Errorf("SteamID64ToSteamID3(%d) = %q, want %q", tt.steamID64, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSteamIDRoundTrip(t *testing.T) {
+	steamID64s := []uint64{76561197960265728, 76561197960265729, 76561197960287930}
+
+	for _, want := range steamID64s {
+		id2 := steamid.SteamID64ToSteamID2(want)
+		got, err := steamid.SteamID2ToSteamID64(id2)
+		if err != nil {
+			t.Fatalf("SteamID2ToSteamID64(%q) error = %v", id2, err)
+		}
+		if got != want {
+			t.Errorf("round trip via SteamID2 %q = %d, want %d", id2, got, want)
+		}
+
+		id3 := steamid.SteamID64ToSteamID3(want)
+		got, err = steamid.SteamID3ToSteamID64(id3)
+		if err != nil {
+			t.Fatalf("SteamID3ToSteamID64(%q) error = %v", id3, err)
+		}
+		if got != want {
+			t.Errorf("round trip via SteamID3 %q = %d, want %d", id3, got, want)
+		}
+	}
+}
+
+func TestSteamID2ToSteamID64Invalid(t *testing.T) {
+	for _, in := range []string{"", "not-a-steamid", "STEAM_0:1", "STEAM_0:x:0"} {
+		if _, err := steamid.SteamID2ToSteamID64(in); err == nil {
+			t.Errorf("SteamID2ToSteamID64(%q) error = nil, want error", in)
+		}
+	}
+}
+
+func TestSteamID3ToSteamID64Invalid(t *testing.T) {
+	for _, in := range []string{"", "not-a-steamid", "[U:1:x]", "76561197960265728"} {
+		if _, err := steamid.SteamID3ToSteamID64(in); err == nil {
+			t.Errorf("SteamID3ToSteamID64(%q) error = nil, want error", in)
+		}
+	}
+}
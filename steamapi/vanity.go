@@ -0,0 +1,50 @@
+package steamapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// ErrVanityNotFound is returned by ResolveVanityURL when Steam has no
+// profile matching the requested vanity name.
+var ErrVanityNotFound = errors.New("steamapi: vanity url not found")
+
+type vanityURLResult struct {
+	Response struct {
+		SteamID string `json:"steamid"`
+		Success int    `json:"success"`
+		Message string `json:"message"`
+	} `json:"response"`
+}
+
+// ResolveVanityURL resolves a custom Steam Community profile name (the part
+// of a steamcommunity.com/id/<vanity> URL after /id/) to its numeric
+// SteamID64. It returns ErrVanityNotFound if Steam has no profile matching
+// vanity.
+func (c *Client) ResolveVanityURL(ctx context.Context, vanity string) (uint64, error) {
+	resp, err := c.do(ctx, userService, "ResolveVanityURL", "v0001", url.Values{
+		"vanityurl": {vanity},
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	var parsed vanityURLResult
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return 0, fmt.Errorf("steamapi: %s %s: %w", userService, "ResolveVanityURL", err)
+	}
+	if parsed.Response.Success != 1 {
+		return 0, ErrVanityNotFound
+	}
+
+	steamID, err := strconv.ParseUint(parsed.Response.SteamID, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("steamapi: %s %s: %w", userService, "ResolveVanityURL", err)
+	}
+	return steamID, nil
+}
@@ -0,0 +1,53 @@
+package steamapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"softsrv/steamapi/steamapi"
+	"softsrv/steamapi/steamapi/steamapitest"
+)
+
+func TestResolveVanityURL(t *testing.T) {
+	tests := []struct {
+		name       string
+		vanity     string
+		want       uint64
+		wantErr    error
+		wantErrNil bool
+	}{
+		{
+			name:       "resolves to a steamid64",
+			vanity:     "robinwalker",
+			want:       76561197960435530,
+			wantErrNil: true,
+		},
+		{
+			name:    "unmatched vanity returns ErrVanityNotFound",
+			vanity:  "nosuchvanity",
+			wantErr: steamapi.ErrVanityNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := steamapitest.NewClient(t, "testdata/vanity.json")
+
+			got, err := client.ResolveVanityURL(context.Background(), tt.vanity)
+			if tt.wantErrNil {
+				if err != nil {
+					t.Fatalf("ResolveVanityURL() error = %v, want nil", err)
+				}
+				if got != tt.want {
+					t.Errorf("ResolveVanityURL() = %d, want %d", got, tt.want)
+				}
+				return
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ResolveVanityURL() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}